@@ -0,0 +1,72 @@
+package gocache
+
+import (
+	"testing"
+	"time"
+)
+
+type testUser struct {
+	Name string
+	Age  int
+}
+
+func TestCacheSetGetDelete(t *testing.T) {
+	t.Run("string keys, string values", func(t *testing.T) {
+		c := NewTypedCache[string, string]()
+		c.Set("greeting", "hello", time.Minute)
+
+		got, ok := c.Get("greeting")
+		if !ok || got != "hello" {
+			t.Fatalf(`Get("greeting") = (%q, %v), want ("hello", true)`, got, ok)
+		}
+
+		c.Delete("greeting")
+		if _, ok := c.Get("greeting"); ok {
+			t.Fatal("Get still found the key after Delete")
+		}
+	})
+
+	t.Run("string keys, struct values", func(t *testing.T) {
+		c := NewTypedCache[string, testUser]()
+		want := testUser{Name: "Ada", Age: 36}
+		c.Set("user:1", want, time.Minute)
+
+		got, ok := c.Get("user:1")
+		if !ok || got != want {
+			t.Fatalf("Get(\"user:1\") = (%+v, %v), want (%+v, true)", got, ok, want)
+		}
+	})
+
+	t.Run("int64 keys, byte slice values", func(t *testing.T) {
+		c := NewTypedCache[int64, []byte]()
+		want := []byte("payload")
+		c.Set(42, want, time.Minute)
+
+		got, ok := c.Get(42)
+		if !ok || string(got) != string(want) {
+			t.Fatalf("Get(42) = (%q, %v), want (%q, true)", got, ok, want)
+		}
+	})
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := NewTypedCache[string, int]()
+	c.Set("n", 1, 10*time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("n"); ok {
+		t.Fatal("Get returned ok=true for an item past its TTL")
+	}
+}
+
+func TestCacheBackwardCompatibleAlias(t *testing.T) {
+	// Cache and NewCache must keep working without naming type parameters,
+	// the way pre-generics callers relied on.
+	c := NewCache()
+	c.Set("k", "v", time.Minute)
+
+	got, ok := c.Get("k")
+	if !ok || got != "v" {
+		t.Fatalf(`Get("k") = (%v, %v), want ("v", true)`, got, ok)
+	}
+}
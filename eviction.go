@@ -0,0 +1,132 @@
+package gocache
+
+import "time"
+
+// EvictionPolicy decides which key to remove when a capacity-bounded Cache
+// needs room for a new entry. CacheWithPolicy calls some policy methods
+// while holding its own lock and others (e.g. OnInsert, OnAccess) after
+// releasing it, so implementations must be safe for concurrent use; LRU and
+// LFU guard their state with their own mutex.
+type EvictionPolicy[K comparable] interface {
+	// OnAccess is called whenever key is read via Get.
+	OnAccess(key K)
+	// OnInsert is called whenever key is added or updated via Set.
+	OnInsert(key K)
+	// OnDelete is called whenever key is removed, whether by Delete, Clear,
+	// expiry, or eviction.
+	OnDelete(key K)
+	// Evict picks a key to remove to make room for a new entry. ok is false
+	// if the policy has nothing to evict.
+	Evict() (key K, ok bool)
+}
+
+// CacheWithPolicy is a Cache bounded to at most maxEntries items, evicting
+// according to policy once that bound would otherwise be exceeded.
+// Time-based expiry, inherited from Cache, coexists with this size-based
+// eviction.
+type CacheWithPolicy[K comparable, V any] struct {
+	*TypedCache[K, V]
+	maxEntries int
+	policy     EvictionPolicy[K]
+
+	// OnEvicted, if set, is called whenever an entry is removed by the
+	// eviction policy to make room for a new one.
+	OnEvicted func(key K, value V)
+}
+
+// NewCacheWithPolicy creates a Cache bounded to maxEntries items, using
+// policy to pick what to evict once that bound is reached.
+func NewCacheWithPolicy[K comparable, V any](maxEntries int, policy EvictionPolicy[K]) *CacheWithPolicy[K, V] {
+	return &CacheWithPolicy[K, V]{
+		TypedCache: NewTypedCache[K, V](),
+		maxEntries: maxEntries,
+		policy:     policy,
+	}
+}
+
+// Set adds or updates a key-value pair, evicting an entry first if the
+// cache is already at maxEntries.
+func (c *CacheWithPolicy[K, V]) Set(key K, value V, ttl time.Duration, opts ...ItemOption[K, V]) {
+	item := newCacheItem(value, ttl, opts)
+
+	c.mu.Lock()
+	if _, exists := c.data[key]; !exists {
+		// Keep asking the policy to evict until it actually frees a slot.
+		// A pick can be stale (e.g. a key the policy hadn't yet heard was
+		// lazily expired by Get) and must be skipped rather than treated
+		// as a real eviction, or the cache would silently grow past
+		// maxEntries by one entry per stale pick.
+		for len(c.data) >= c.maxEntries {
+			evictKey, ok := c.policy.Evict()
+			if !ok {
+				break
+			}
+			evicted, hadEntry := c.data[evictKey]
+			delete(c.data, evictKey)
+			c.policy.OnDelete(evictKey)
+			if !hadEntry {
+				continue
+			}
+			if evicted.onEvict != nil {
+				evicted.onEvict(evictKey, evicted.value)
+			}
+			if c.OnEvicted != nil {
+				c.OnEvicted(evictKey, evicted.value)
+			}
+			break
+		}
+	}
+	c.data[key] = item
+	c.mu.Unlock()
+
+	c.policy.OnInsert(key)
+}
+
+// Get retrieves the value for key, notifying the policy of the access. If
+// key was lazily expired by the embedded TypedCache's own Get, the policy
+// is told via OnDelete so it doesn't keep a stale node around for a key
+// that's no longer in the cache; OnDelete on a key the policy isn't
+// tracking is a no-op.
+func (c *CacheWithPolicy[K, V]) Get(key K) (V, bool) {
+	value, ok := c.TypedCache.Get(key)
+	if ok {
+		c.policy.OnAccess(key)
+		return value, true
+	}
+	c.policy.OnDelete(key)
+	return value, false
+}
+
+// Delete removes key, notifying the policy.
+func (c *CacheWithPolicy[K, V]) Delete(key K) {
+	c.TypedCache.Delete(key)
+	c.policy.OnDelete(key)
+}
+
+// Clear removes all entries, notifying the policy of every removed key.
+func (c *CacheWithPolicy[K, V]) Clear() {
+	c.mu.Lock()
+	old := c.data
+	c.data = make(map[K]CacheItem[K, V])
+	for key := range old {
+		c.policy.OnDelete(key)
+	}
+	c.mu.Unlock()
+
+	for key, item := range old {
+		if item.onEvict != nil {
+			item.onEvict(key, item.value)
+		}
+	}
+}
+
+// GetOrLoad behaves like TypedCache.GetOrLoad, but routes its Get/Set
+// through CacheWithPolicy's own, so a load that populates the cache still
+// respects maxEntries and the eviction policy. Without this override, the
+// embedded TypedCache's GetOrLoad would resolve its internal Get/Set calls
+// to TypedCache's own, bypassing policy and capacity bookkeeping entirely.
+func (c *CacheWithPolicy[K, V]) GetOrLoad(key K, ttl time.Duration, loader func() (V, error)) (V, error) {
+	return groupGetOrLoad(&c.sf, key, ttl, loader, c.Get, func(k K, v V, d time.Duration) {
+		c.Set(k, v, d)
+	})
+}
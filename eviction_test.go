@@ -0,0 +1,57 @@
+package gocache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheWithPolicyRespectsMaxEntries(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](2, NewLRU[string]())
+	c.Set("a", 1, time.Minute)
+	c.Set("b", 2, time.Minute)
+	c.Set("c", 3, time.Minute)
+
+	if got := len(c.data); got != 2 {
+		t.Fatalf("len(data) = %d, want 2", got)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatal(`least recently used key "a" should have been evicted`)
+	}
+}
+
+func TestCacheWithPolicyEvictsPastLazyExpiry(t *testing.T) {
+	// A key that expires lazily (via Get, not Delete) must not leave a
+	// stale node in the policy that later gets picked as a no-op eviction,
+	// letting the cache grow past maxEntries.
+	c := NewCacheWithPolicy[string, int](2, NewLRU[string]())
+	c.Set("a", 1, 10*time.Millisecond)
+	c.Set("b", 2, time.Minute)
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal(`expected "a" to have expired`)
+	}
+
+	c.Set("c", 3, time.Minute)
+	c.Set("d", 4, time.Minute)
+
+	if got := len(c.data); got != 2 {
+		t.Fatalf("len(data) = %d, want 2 (cache grew past maxEntries after a lazy expiry)", got)
+	}
+}
+
+func TestCacheWithPolicyRespectsMaxEntriesWithLFU(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](2, NewLFU[string]())
+	c.Set("a", 1, time.Minute)
+	c.Set("b", 2, time.Minute)
+	c.Get("b") // bump "b" so "a" is the least frequently used
+
+	c.Set("c", 3, time.Minute)
+
+	if got := len(c.data); got != 2 {
+		t.Fatalf("len(data) = %d, want 2", got)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatal(`least frequently used key "a" should have been evicted`)
+	}
+}
@@ -0,0 +1,93 @@
+package gocache
+
+import (
+	"runtime"
+	"time"
+)
+
+// CacheWithJanitor wraps a Cache and proactively sweeps expired entries in
+// the background, instead of relying solely on Get to reclaim them.
+//
+// The sweep loop and its ticker live on the unexported inner type so that a
+// caller who forgets to call Close doesn't leak the goroutine: the
+// finalizer below runs on the outer wrapper, stops the ticker, and lets the
+// inner cache go with it once nothing references the wrapper anymore.
+type CacheWithJanitor[K comparable, V any] struct {
+	*cacheJanitor[K, V]
+}
+
+type cacheJanitor[K comparable, V any] struct {
+	*TypedCache[K, V]
+	ticker *time.Ticker
+	stop   chan struct{}
+}
+
+// NewCacheWithJanitor creates a Cache whose expired entries are swept by a
+// background goroutine every cleanupInterval, in addition to the lazy
+// expiry check Get already performs. Call Close when done with it to stop
+// the goroutine immediately; if the caller forgets, a finalizer on the
+// returned wrapper stops it once the wrapper is garbage collected.
+func NewCacheWithJanitor[K comparable, V any](cleanupInterval time.Duration) *CacheWithJanitor[K, V] {
+	inner := &cacheJanitor[K, V]{
+		TypedCache: NewTypedCache[K, V](),
+		ticker:     time.NewTicker(cleanupInterval),
+		stop:       make(chan struct{}),
+	}
+	go inner.run()
+
+	wrapper := &CacheWithJanitor[K, V]{cacheJanitor: inner}
+	runtime.SetFinalizer(wrapper, func(w *CacheWithJanitor[K, V]) {
+		w.stopJanitor()
+	})
+	return wrapper
+}
+
+func (c *cacheJanitor[K, V]) run() {
+	for {
+		select {
+		case <-c.ticker.C:
+			c.sweep()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// sweep deletes every entry whose expiry has already passed, notifying any
+// per-item onEvict callbacks.
+func (c *cacheJanitor[K, V]) sweep() {
+	now := time.Now()
+
+	c.mu.Lock()
+	var expired []CacheItem[K, V]
+	var expiredKeys []K
+	for key, item := range c.data {
+		if isExpired(item.expiry, now) {
+			delete(c.data, key)
+			expiredKeys = append(expiredKeys, key)
+			expired = append(expired, item)
+		}
+	}
+	c.mu.Unlock()
+
+	for i, item := range expired {
+		if item.onEvict != nil {
+			item.onEvict(expiredKeys[i], item.value)
+		}
+	}
+}
+
+func (c *cacheJanitor[K, V]) stopJanitor() {
+	c.ticker.Stop()
+	select {
+	case <-c.stop:
+		// already stopped
+	default:
+		close(c.stop)
+	}
+}
+
+// Close stops the janitor goroutine. It is safe to call more than once.
+func (c *CacheWithJanitor[K, V]) Close() {
+	c.stopJanitor()
+}
@@ -0,0 +1,122 @@
+package gocache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LFU is an EvictionPolicy that evicts the least frequently used key. Keys
+// are grouped into frequency buckets so that bumping a key's frequency on
+// access and finding the least-used key to evict are both O(1).
+type LFU[K comparable] struct {
+	mu       sync.Mutex
+	freq     map[K]int
+	buckets  map[int]*list.List
+	elements map[K]*list.Element
+	minFreq  int
+}
+
+// NewLFU creates an empty LFU eviction policy.
+func NewLFU[K comparable]() *LFU[K] {
+	return &LFU[K]{
+		freq:     make(map[K]int),
+		buckets:  make(map[int]*list.List),
+		elements: make(map[K]*list.Element),
+	}
+}
+
+// OnAccess bumps key to the next frequency bucket.
+func (l *LFU[K]) OnAccess(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.touch(key)
+}
+
+// OnInsert starts tracking key at frequency 1, or bumps it if already known.
+func (l *LFU[K]) OnInsert(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.freq[key]; ok {
+		l.touch(key)
+		return
+	}
+	l.freq[key] = 1
+	if l.buckets[1] == nil {
+		l.buckets[1] = list.New()
+	}
+	l.elements[key] = l.buckets[1].PushFront(key)
+	l.minFreq = 1
+}
+
+// touch moves key from its current frequency bucket to the next one.
+// Callers must hold l.mu.
+func (l *LFU[K]) touch(key K) {
+	el, ok := l.elements[key]
+	if !ok {
+		return
+	}
+	freq := l.freq[key]
+	l.buckets[freq].Remove(el)
+	if l.buckets[freq].Len() == 0 {
+		delete(l.buckets, freq)
+	}
+	freq++
+	l.freq[key] = freq
+	if l.buckets[freq] == nil {
+		l.buckets[freq] = list.New()
+	}
+	l.elements[key] = l.buckets[freq].PushFront(key)
+	l.advanceMinFreq()
+}
+
+// advanceMinFreq moves minFreq forward past any bucket that's been drained
+// empty, so Evict doesn't keep reading an emptied bucket forever once the
+// current minimum-frequency bucket runs dry while higher buckets still hold
+// entries. Callers must hold l.mu.
+func (l *LFU[K]) advanceMinFreq() {
+	for len(l.freq) > 0 {
+		bucket := l.buckets[l.minFreq]
+		if bucket != nil && bucket.Len() > 0 {
+			return
+		}
+		l.minFreq++
+	}
+}
+
+// OnDelete stops tracking key.
+func (l *LFU[K]) OnDelete(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	freq, ok := l.freq[key]
+	if !ok {
+		return
+	}
+	l.buckets[freq].Remove(l.elements[key])
+	if l.buckets[freq].Len() == 0 {
+		delete(l.buckets, freq)
+	}
+	delete(l.elements, key)
+	delete(l.freq, key)
+	l.advanceMinFreq()
+}
+
+// Evict returns the least frequently used key, if any, and stops tracking it.
+func (l *LFU[K]) Evict() (key K, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.advanceMinFreq()
+	bucket := l.buckets[l.minFreq]
+	if bucket == nil || bucket.Len() == 0 {
+		var zero K
+		return zero, false
+	}
+	back := bucket.Back()
+	key = back.Value.(K)
+	bucket.Remove(back)
+	if bucket.Len() == 0 {
+		delete(l.buckets, l.minFreq)
+	}
+	delete(l.elements, key)
+	delete(l.freq, key)
+	return key, true
+}
@@ -0,0 +1,41 @@
+package gocache
+
+import "testing"
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	l := NewLFU[string]()
+	l.OnInsert("a")
+	l.OnInsert("b")
+	l.OnInsert("c")
+	l.OnAccess("b")
+	l.OnAccess("c")
+	l.OnAccess("c")
+
+	key, ok := l.Evict()
+	if !ok || key != "a" {
+		t.Fatalf(`Evict() = (%q, %v), want ("a", true)`, key, ok)
+	}
+}
+
+// TestLFUEvictsPastDrainedMinBucket reproduces a cache that would otherwise
+// grow past its bound: once the frequency-1 bucket is fully drained, Evict
+// must advance to the next non-empty bucket instead of reading the drained
+// one forever.
+func TestLFUEvictsPastDrainedMinBucket(t *testing.T) {
+	l := NewLFU[string]()
+	l.OnInsert("a")
+	l.OnInsert("b")
+	l.OnAccess("b") // bumps "b" to frequency 2, leaving only "a" at frequency 1
+
+	key, ok := l.Evict()
+	if !ok || key != "a" {
+		t.Fatalf(`first Evict() = (%q, %v), want ("a", true)`, key, ok)
+	}
+
+	// The frequency-1 bucket is now empty, but "b" is still tracked at
+	// frequency 2 and must still be evictable.
+	key, ok = l.Evict()
+	if !ok || key != "b" {
+		t.Fatalf(`second Evict() = (%q, %v), want ("b", true)`, key, ok)
+	}
+}
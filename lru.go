@@ -0,0 +1,68 @@
+package gocache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRU is an EvictionPolicy that evicts the least recently used key. It uses
+// a doubly-linked list plus a map of key to list element for O(1) touch and
+// eviction, the same structure minio/lru and similar caches use.
+type LRU[K comparable] struct {
+	mu       sync.Mutex
+	ll       *list.List
+	elements map[K]*list.Element
+}
+
+// NewLRU creates an empty LRU eviction policy.
+func NewLRU[K comparable]() *LRU[K] {
+	return &LRU[K]{
+		ll:       list.New(),
+		elements: make(map[K]*list.Element),
+	}
+}
+
+// OnAccess moves key to the front of the list, marking it most recently used.
+func (l *LRU[K]) OnAccess(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if el, ok := l.elements[key]; ok {
+		l.ll.MoveToFront(el)
+	}
+}
+
+// OnInsert tracks key as most recently used, whether it's new or updated.
+func (l *LRU[K]) OnInsert(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if el, ok := l.elements[key]; ok {
+		l.ll.MoveToFront(el)
+		return
+	}
+	l.elements[key] = l.ll.PushFront(key)
+}
+
+// OnDelete stops tracking key.
+func (l *LRU[K]) OnDelete(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if el, ok := l.elements[key]; ok {
+		l.ll.Remove(el)
+		delete(l.elements, key)
+	}
+}
+
+// Evict returns the least recently used key, if any, and stops tracking it.
+func (l *LRU[K]) Evict() (key K, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	back := l.ll.Back()
+	if back == nil {
+		var zero K
+		return zero, false
+	}
+	key = back.Value.(K)
+	l.ll.Remove(back)
+	delete(l.elements, key)
+	return key, true
+}
@@ -6,67 +6,129 @@ import (
 	"time"
 )
 
-// CacheItem represents an item stored in the cache with its associated TTL.
-type CacheItem struct {
-	value  interface{}
-	expiry time.Time // TTL for a key
+// CacheItem represents an item stored in the cache along with its expiry
+// and any per-item options that were set when it was stored.
+type CacheItem[K comparable, V any] struct {
+	value   V
+	expiry  time.Time // zero means "never expires"
+	ttl     time.Duration
+	sliding bool
+	onEvict func(key K, value V)
 }
 
-// Cache represents an in-memory key-value store with expiry support.
-type Cache struct {
-	data map[string]CacheItem
+// TypedCache represents an in-memory key-value store with expiry support.
+// K must be comparable so it can be used as a map key, while V may be any
+// type. This lets callers instantiate typed caches for heterogeneous data
+// (e.g. *TypedCache[string, User], *TypedCache[int64, []byte]) without
+// paying for interface{} boxing at Get sites.
+type TypedCache[K comparable, V any] struct {
+	data map[K]CacheItem[K, V]
 	mu   sync.RWMutex
+	sf   singleflightGroup[K, V]
 }
 
-// NewCache creates and initializes a new Cache instance.
-func NewCache() *Cache {
-	return &Cache{
-		data: make(map[string]CacheItem),
+// NewTypedCache creates and initializes a new TypedCache instance.
+func NewTypedCache[K comparable, V any]() *TypedCache[K, V] {
+	return &TypedCache[K, V]{
+		data: make(map[K]CacheItem[K, V]),
 	}
 }
 
+// Cache is a thin alias over the generic TypedCache for callers that don't
+// need to name type parameters, keeping the original untyped (string keys,
+// interface{} values) Cache identifier usable as-is, e.g. `var c *Cache`.
+type Cache = TypedCache[string, interface{}]
+
+// NewCache creates and initializes a new Cache (TypedCache[string,
+// interface{}]) instance, for backward-compatible, untyped usage. Use
+// NewTypedCache directly to get compile-time type safety for a specific
+// K/V pair.
+func NewCache() *Cache {
+	return NewTypedCache[string, interface{}]()
+}
+
 // Set adds or updates a key-value pair in the cache with the given TTL.
-func (c *Cache) Set(key string, value interface{}, ttl time.Duration) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// Pass ItemOption values to customize the item, e.g. WithNoExpiration,
+// WithSlidingTTL, or WithOnEvict.
+func (c *TypedCache[K, V]) Set(key K, value V, ttl time.Duration, opts ...ItemOption[K, V]) {
+	item := newCacheItem(value, ttl, opts)
 
-	c.data[key] = CacheItem{
-		value:  value,
-		expiry: time.Now().Add(ttl),
-	}
+	c.mu.Lock()
+	c.data[key] = item
+	c.mu.Unlock()
 }
 
 // Get retrieves the value associated with the given key from the cache.
-// It also checks for expiry and removes expired items.
-func (c *Cache) Get(key string) (interface{}, bool) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// It also checks for expiry and removes expired items. If the item was
+// stored with WithSlidingTTL, a successful Get extends its expiry by its
+// original TTL.
+func (c *TypedCache[K, V]) Get(key K) (V, bool) {
+	now := time.Now()
 
+	c.mu.RLock()
 	item, ok := c.data[key]
+	c.mu.RUnlock()
 	if !ok {
-		return nil, false
+		var zero V
+		return zero, false
 	}
-	// item found - check for expiry
-	if item.expiry.Before(time.Now()) {
-		// remove entry from cache if time is beyond the expiry
-		delete(c.data, key)
-		return nil, false
+
+	if isExpired(item.expiry, now) {
+		c.mu.Lock()
+		// re-check under the write lock in case another goroutine already
+		// refreshed or removed it
+		current, ok := c.data[key]
+		if !ok || isExpired(current.expiry, now) {
+			delete(c.data, key)
+			c.mu.Unlock()
+			if ok && current.onEvict != nil {
+				current.onEvict(key, current.value)
+			}
+			var zero V
+			return zero, false
+		}
+		c.mu.Unlock()
+		item = current
+	}
+
+	if item.sliding && !item.expiry.IsZero() {
+		// only take the write lock when sliding mode actually needs to
+		// bump the expiry
+		c.mu.Lock()
+		if current, ok := c.data[key]; ok {
+			current.expiry = now.Add(current.ttl)
+			c.data[key] = current
+		}
+		c.mu.Unlock()
 	}
+
 	return item.value, true
 }
 
 // Delete removes a key-value pair from the cache.
-func (c *Cache) Delete(key string) {
+func (c *TypedCache[K, V]) Delete(key K) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	item, ok := c.data[key]
 	delete(c.data, key)
+	c.mu.Unlock()
+
+	if ok && item.onEvict != nil {
+		item.onEvict(key, item.value)
+	}
 }
 
 // Clear removes all key-value pairs from the cache.
-func (c *Cache) Clear() {
+func (c *TypedCache[K, V]) Clear() {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.data = make(map[string]CacheItem)
+	old := c.data
+	c.data = make(map[K]CacheItem[K, V])
+	c.mu.Unlock()
+
+	for key, item := range old {
+		if item.onEvict != nil {
+			item.onEvict(key, item.value)
+		}
+	}
 }
 
 func main() {
@@ -0,0 +1,65 @@
+package gocache
+
+import "time"
+
+// isExpired reports whether expiry has already passed as of now. A zero
+// Time means "never expires", set via WithNoExpiration.
+func isExpired(expiry time.Time, now time.Time) bool {
+	return !expiry.IsZero() && expiry.Before(now)
+}
+
+// ItemOption customizes the item passed to Set. Because Go can't infer type
+// parameters from a variadic option slice, callers must name K and V
+// explicitly, e.g. gocache.WithSlidingTTL[string, Session]().
+type ItemOption[K comparable, V any] func(*itemOptions[K, V])
+
+type itemOptions[K comparable, V any] struct {
+	noExpiration bool
+	sliding      bool
+	onEvict      func(key K, value V)
+}
+
+// WithNoExpiration marks the item as never expiring, regardless of the ttl
+// passed to Set.
+func WithNoExpiration[K comparable, V any]() ItemOption[K, V] {
+	return func(o *itemOptions[K, V]) {
+		o.noExpiration = true
+	}
+}
+
+// WithSlidingTTL extends the item's expiry by its original TTL on every
+// successful Get, so it stays alive as long as it keeps being read. This is
+// useful for session-style caches. It has no effect combined with
+// WithNoExpiration, since a non-expiring item has nothing to extend.
+func WithSlidingTTL[K comparable, V any]() ItemOption[K, V] {
+	return func(o *itemOptions[K, V]) {
+		o.sliding = true
+	}
+}
+
+// WithOnEvict registers fn to be called when the item is removed, whether
+// by expiry, Delete, Clear, or capacity-based eviction.
+func WithOnEvict[K comparable, V any](fn func(key K, value V)) ItemOption[K, V] {
+	return func(o *itemOptions[K, V]) {
+		o.onEvict = fn
+	}
+}
+
+// newCacheItem applies opts and builds the CacheItem Set should store.
+func newCacheItem[K comparable, V any](value V, ttl time.Duration, opts []ItemOption[K, V]) CacheItem[K, V] {
+	var cfg itemOptions[K, V]
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	item := CacheItem[K, V]{
+		value:   value,
+		ttl:     ttl,
+		sliding: cfg.sliding,
+		onEvict: cfg.onEvict,
+	}
+	if !cfg.noExpiration {
+		item.expiry = time.Now().Add(ttl)
+	}
+	return item
+}
@@ -0,0 +1,102 @@
+package gocache
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSlidingTTLExtendsExpiryOnGet(t *testing.T) {
+	c := NewTypedCache[string, string]()
+	c.Set("session", "alice", 30*time.Millisecond, WithSlidingTTL[string, string]())
+
+	// Keep reading well past the original TTL; each Get should push the
+	// expiry out again.
+	deadline := time.Now().Add(100 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		if _, ok := c.Get("session"); !ok {
+			t.Fatal("sliding TTL item expired despite being read continuously")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Once reads stop, it should expire after roughly the original TTL.
+	time.Sleep(50 * time.Millisecond)
+	if _, ok := c.Get("session"); ok {
+		t.Fatal("sliding TTL item never expired after reads stopped")
+	}
+}
+
+func TestSlidingTTLHasNoEffectWithNoExpiration(t *testing.T) {
+	c := NewTypedCache[string, string]()
+	c.Set("forever", "v", 10*time.Millisecond,
+		WithNoExpiration[string, string](),
+		WithSlidingTTL[string, string]())
+
+	// A Get that would otherwise bump a sliding expiry must not turn a
+	// never-expiring item into one that expires.
+	if _, ok := c.Get("forever"); !ok {
+		t.Fatal("expected item to be present")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("forever"); !ok {
+		t.Fatal("WithNoExpiration item expired despite being combined with WithSlidingTTL")
+	}
+}
+
+func TestJanitorSweepsExpiredButSlidingSurvivesUnderRead(t *testing.T) {
+	jc := NewCacheWithJanitor[string, string](10 * time.Millisecond)
+	defer jc.Close()
+
+	const ttl = 40 * time.Millisecond
+	jc.Set("plain", "v", ttl)
+	jc.Set("session", "v", ttl, WithSlidingTTL[string, string]())
+
+	// Read "session" several times an interval well inside the TTL, so its
+	// expiry keeps getting pushed out with a comfortable margin instead of
+	// racing the exact bump.
+	readDeadline := time.Now().Add(3 * ttl)
+	for time.Now().Before(readDeadline) {
+		if _, ok := jc.Get("session"); !ok {
+			t.Fatal("sliding item expired despite being read inside every window")
+		}
+		time.Sleep(ttl / 4)
+	}
+
+	// "plain" was never read again after its one Set, so the janitor should
+	// sweep it well before now. Poll instead of asserting at one fixed
+	// instant, so this doesn't race the janitor's own tick. The map is
+	// inspected directly, since Get would lazily drop the item itself.
+	pollDeadline := time.Now().Add(3 * ttl)
+	for {
+		jc.mu.RLock()
+		_, present := jc.data["plain"]
+		jc.mu.RUnlock()
+		if !present {
+			break
+		}
+		if time.Now().After(pollDeadline) {
+			t.Fatal("janitor should have swept the unread, plain item by now")
+		}
+		time.Sleep(ttl / 4)
+	}
+
+	if _, ok := jc.Get("session"); !ok {
+		t.Fatal("sliding item under continuous read should have survived the janitor")
+	}
+}
+
+func TestOnEvictFiresOnJanitorSweep(t *testing.T) {
+	var evicted int32
+	jc := NewCacheWithJanitor[string, string](10 * time.Millisecond)
+	defer jc.Close()
+
+	jc.Set("k", "v", 15*time.Millisecond, WithOnEvict[string, string](func(k, v string) {
+		atomic.AddInt32(&evicted, 1)
+	}))
+
+	time.Sleep(60 * time.Millisecond)
+	if got := atomic.LoadInt32(&evicted); got != 1 {
+		t.Fatalf("onEvict called %d times, want 1", got)
+	}
+}
@@ -0,0 +1,98 @@
+package gocache
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+)
+
+// gobEntry is the on-disk representation of one cache entry.
+type gobEntry[K comparable, V any] struct {
+	Key            K
+	Value          V
+	ExpiryUnixNano int64
+}
+
+// Save writes every entry in the cache to w using encoding/gob, including
+// ones that have already expired (Load skips those when reading back). An
+// item stored with WithNoExpiration round-trips as never-expiring; its
+// sliding-TTL flag and onEvict callback do not survive the round trip,
+// since a callback can't be serialized. If V is an interface type, callers
+// must gob.Register its concrete types before calling Save or Load.
+func (c *TypedCache[K, V]) Save(w io.Writer) error {
+	c.mu.RLock()
+	entries := make([]gobEntry[K, V], 0, len(c.data))
+	for k, item := range c.data {
+		e := gobEntry[K, V]{Key: k, Value: item.value}
+		if !item.expiry.IsZero() {
+			e.ExpiryUnixNano = item.expiry.UnixNano()
+		}
+		entries = append(entries, e)
+	}
+	c.mu.RUnlock()
+
+	return gob.NewEncoder(w).Encode(entries)
+}
+
+// SaveFile writes the cache contents to the file at path, creating or
+// truncating it as needed.
+func (c *TypedCache[K, V]) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Save(f)
+}
+
+// Load reads entries written by Save from r and merges them into the
+// cache. Entries whose expiry has already passed are skipped. A decoded
+// entry only overwrites a live entry already in the cache if the decoded
+// one is at least as fresh (treating "never expires" as fresher than any
+// concrete expiry), so Load never clobbers fresher live data with stale
+// data.
+func (c *TypedCache[K, V]) Load(r io.Reader) error {
+	var entries []gobEntry[K, V]
+	if err := gob.NewDecoder(r).Decode(&entries); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range entries {
+		var expiry time.Time
+		if e.ExpiryUnixNano != 0 {
+			expiry = time.Unix(0, e.ExpiryUnixNano)
+			if expiry.Before(now) {
+				continue
+			}
+		}
+		if existing, ok := c.data[e.Key]; ok && freshness(existing.expiry).After(freshness(expiry)) {
+			continue
+		}
+		c.data[e.Key] = CacheItem[K, V]{value: e.Value, expiry: expiry}
+	}
+	return nil
+}
+
+// freshness orders expiries for comparison, treating a zero Time ("never
+// expires") as later than any concrete expiry.
+func freshness(expiry time.Time) time.Time {
+	if expiry.IsZero() {
+		return time.Unix(1<<62, 0)
+	}
+	return expiry
+}
+
+// LoadFile reads entries written by SaveFile from the file at path and
+// merges them into the cache, as Load does.
+func (c *TypedCache[K, V]) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Load(f)
+}
@@ -0,0 +1,98 @@
+package gocache
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestCacheSaveLoadRoundTrip(t *testing.T) {
+	src := NewTypedCache[string, int]()
+	src.Set("a", 1, time.Minute)
+	src.Set("b", 2, time.Minute)
+
+	var buf bytes.Buffer
+	if err := src.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	dst := NewTypedCache[string, int]()
+	if err := dst.Load(&buf); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	for _, key := range []string{"a", "b"} {
+		want, _ := src.Get(key)
+		got, ok := dst.Get(key)
+		if !ok || got != want {
+			t.Fatalf("Get(%q) after Load = (%v, %v), want (%v, true)", key, got, ok, want)
+		}
+	}
+}
+
+func TestCacheSaveLoadStructValues(t *testing.T) {
+	src := NewTypedCache[string, testUser]()
+	want := testUser{Name: "Grace", Age: 40}
+	src.Set("user", want, time.Minute)
+
+	var buf bytes.Buffer
+	if err := src.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	dst := NewTypedCache[string, testUser]()
+	if err := dst.Load(&buf); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	got, ok := dst.Get("user")
+	if !ok || got != want {
+		t.Fatalf(`Get("user") after Load = (%+v, %v), want (%+v, true)`, got, ok, want)
+	}
+}
+
+func TestCacheLoadSkipsExpiredAndKeepsFresherLive(t *testing.T) {
+	src := NewTypedCache[string, string]()
+	src.Set("expired", "stale", 10*time.Millisecond)
+	src.Set("live", "fresh", time.Minute)
+	time.Sleep(20 * time.Millisecond)
+
+	var buf bytes.Buffer
+	// Save encodes whatever is still in data, expired or not: "expired"
+	// hasn't been lazily reaped yet because nothing has called Get on it.
+	if err := src.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	dst := NewTypedCache[string, string]()
+	dst.Set("live", "already-here-and-fresher", time.Hour)
+	if err := dst.Load(&buf); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if _, ok := dst.Get("expired"); ok {
+		t.Fatal("Load should have skipped the already-expired entry")
+	}
+	if got, _ := dst.Get("live"); got != "already-here-and-fresher" {
+		t.Fatalf("Load clobbered a fresher live entry: got %q", got)
+	}
+}
+
+func TestCacheSaveLoadNoExpiration(t *testing.T) {
+	src := NewTypedCache[string, string]()
+	src.Set("forever", "v", time.Minute, WithNoExpiration[string, string]())
+
+	var buf bytes.Buffer
+	if err := src.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	dst := NewTypedCache[string, string]()
+	if err := dst.Load(&buf); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if got, ok := dst.Get("forever"); !ok || got != "v" {
+		t.Fatalf(`Get("forever") after Load = (%q, %v), want ("v", true)`, got, ok)
+	}
+}
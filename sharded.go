@@ -0,0 +1,115 @@
+package gocache
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+// defaultShardCount is used when callers don't have a more specific number
+// in mind. BenchmarkShardedCache_* in sharded_test.go compares this against
+// 16 and 256 shards (plus a 1-shard, single-mutex baseline) on a mixed
+// 90/10 read/write workload; 64 is the middle ground that keeps per-shard
+// lock contention low without the map/slice overhead of 256 mostly-empty
+// shards.
+const defaultShardCount = 64
+
+// ShardedCache fans string keys out across N independent Cache shards, each
+// with its own mutex, to reduce lock contention under high concurrency
+// compared to a single Cache guarded by one sync.RWMutex.
+type ShardedCache[V any] struct {
+	shards []*TypedCache[string, V]
+	mask   uint32
+}
+
+// NewShardedCache creates a ShardedCache with shardCount shards, which must
+// be a power of two so the shard for a key can be picked with a bitmask.
+// Use NewDefaultShardedCache if you don't need to tune this.
+func NewShardedCache[V any](shardCount int) *ShardedCache[V] {
+	if shardCount <= 0 || shardCount&(shardCount-1) != 0 {
+		panic("gocache: shardCount must be a power of two")
+	}
+	shards := make([]*TypedCache[string, V], shardCount)
+	for i := range shards {
+		shards[i] = NewTypedCache[string, V]()
+	}
+	return &ShardedCache[V]{shards: shards, mask: uint32(shardCount - 1)}
+}
+
+// NewDefaultShardedCache creates a ShardedCache with defaultShardCount shards.
+func NewDefaultShardedCache[V any]() *ShardedCache[V] {
+	return NewShardedCache[V](defaultShardCount)
+}
+
+// fnv1a hashes key with 32-bit FNV-1a, used to pick a key's shard.
+func fnv1a(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
+
+func (s *ShardedCache[V]) shardFor(key string) *TypedCache[string, V] {
+	return s.shards[fnv1a(key)&s.mask]
+}
+
+// Set adds or updates a key-value pair in the cache with the given TTL.
+func (s *ShardedCache[V]) Set(key string, value V, ttl time.Duration, opts ...ItemOption[string, V]) {
+	s.shardFor(key).Set(key, value, ttl, opts...)
+}
+
+// Get retrieves the value associated with the given key from the cache.
+func (s *ShardedCache[V]) Get(key string) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Delete removes a key-value pair from the cache.
+func (s *ShardedCache[V]) Delete(key string) {
+	s.shardFor(key).Delete(key)
+}
+
+// Clear removes all key-value pairs from every shard.
+func (s *ShardedCache[V]) Clear() {
+	for _, shard := range s.shards {
+		shard.Clear()
+	}
+}
+
+// Len returns the total number of entries across all shards, including any
+// not yet reclaimed by a lazy expiry check.
+func (s *ShardedCache[V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		shard.mu.RLock()
+		total += len(shard.data)
+		shard.mu.RUnlock()
+	}
+	return total
+}
+
+// Range calls fn for every live, unexpired key-value pair, one shard at a
+// time, so it never holds more than one shard's lock at once. It stops
+// early if fn returns false. Entries written concurrently to a shard after
+// Range has already snapshotted it may not be observed.
+func (s *ShardedCache[V]) Range(fn func(key string, value V) bool) {
+	now := time.Now()
+	for _, shard := range s.shards {
+		for k, v := range shard.snapshot(now) {
+			if !fn(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of all live, unexpired entries as of now.
+func (c *TypedCache[K, V]) snapshot(now time.Time) map[K]V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[K]V, len(c.data))
+	for k, item := range c.data {
+		if isExpired(item.expiry, now) {
+			continue
+		}
+		out[k] = item.value
+	}
+	return out
+}
@@ -0,0 +1,148 @@
+package gocache
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// benchmarkShardedCache drives a mixed 90% read / 10% write workload across
+// many goroutines against a ShardedCache with the given shard count.
+// shardCount == 1 is the single-mutex baseline: one shard behaves like a
+// plain Cache guarded by one sync.RWMutex.
+func benchmarkShardedCache(b *testing.B, shardCount int) {
+	const keyCount = 1000
+
+	c := NewShardedCache[int](shardCount)
+	for i := 0; i < keyCount; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := strconv.Itoa(i % keyCount)
+			if i%10 == 0 {
+				c.Set(key, i, time.Minute)
+			} else {
+				c.Get(key)
+			}
+			i++
+		}
+	})
+}
+
+func TestShardedCacheSetGetDelete(t *testing.T) {
+	c := NewShardedCache[int](4)
+	for i := 0; i < 20; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+	for i := 0; i < 20; i++ {
+		key := strconv.Itoa(i)
+		if got, ok := c.Get(key); !ok || got != i {
+			t.Fatalf("Get(%q) = (%d, %v), want (%d, true)", key, got, ok, i)
+		}
+	}
+
+	c.Delete("5")
+	if _, ok := c.Get("5"); ok {
+		t.Fatal(`Get("5") after Delete should report missing`)
+	}
+}
+
+func TestShardedCacheKeysDistributeAcrossShards(t *testing.T) {
+	c := NewShardedCache[int](8)
+	seen := make(map[*TypedCache[string, int]]bool)
+	for i := 0; i < 100; i++ {
+		seen[c.shardFor(strconv.Itoa(i))] = true
+	}
+	if len(seen) < 2 {
+		t.Fatalf("100 keys all hashed to %d shard(s), want more spread", len(seen))
+	}
+}
+
+func TestShardedCacheLenSumsAcrossShards(t *testing.T) {
+	c := NewShardedCache[int](8)
+	for i := 0; i < 50; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+	if got := c.Len(); got != 50 {
+		t.Fatalf("Len() = %d, want 50", got)
+	}
+}
+
+func TestShardedCacheRangeSkipsExpiredAndVisitsEveryLiveKey(t *testing.T) {
+	c := NewShardedCache[int](4)
+	for i := 0; i < 10; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+	c.Set("expired", -1, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	seen := make(map[string]int)
+	c.Range(func(key string, value int) bool {
+		seen[key] = value
+		return true
+	})
+
+	if _, ok := seen["expired"]; ok {
+		t.Fatal("Range visited an already-expired entry")
+	}
+	for i := 0; i < 10; i++ {
+		key := strconv.Itoa(i)
+		if got, ok := seen[key]; !ok || got != i {
+			t.Fatalf("Range missed or mismatched key %q: got (%v, %v), want (%d, true)", key, got, ok, i)
+		}
+	}
+}
+
+func TestShardedCacheRangeStopsEarly(t *testing.T) {
+	c := NewShardedCache[int](4)
+	for i := 0; i < 10; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+
+	visited := 0
+	c.Range(func(key string, value int) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Fatalf("Range visited %d entries after returning false, want 1", visited)
+	}
+}
+
+func TestShardedCacheClearEmptiesAllShards(t *testing.T) {
+	c := NewShardedCache[int](8)
+	for i := 0; i < 50; i++ {
+		c.Set(strconv.Itoa(i), i, time.Minute)
+	}
+
+	c.Clear()
+
+	if got := c.Len(); got != 0 {
+		t.Fatalf("Len() after Clear = %d, want 0", got)
+	}
+	for i := 0; i < 50; i++ {
+		if _, ok := c.Get(strconv.Itoa(i)); ok {
+			t.Fatalf("Get(%d) after Clear still found a value", i)
+		}
+	}
+}
+
+func BenchmarkShardedCache_1Shard(b *testing.B) {
+	benchmarkShardedCache(b, 1)
+}
+
+func BenchmarkShardedCache_16Shards(b *testing.B) {
+	benchmarkShardedCache(b, 16)
+}
+
+func BenchmarkShardedCache_64Shards(b *testing.B) {
+	benchmarkShardedCache(b, 64)
+}
+
+func BenchmarkShardedCache_256Shards(b *testing.B) {
+	benchmarkShardedCache(b, 256)
+}
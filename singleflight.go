@@ -0,0 +1,80 @@
+package gocache
+
+import (
+	"sync"
+	"time"
+)
+
+// inflight tracks a single in-progress loader call so concurrent GetOrLoad
+// callers for the same key share its result instead of each running loader.
+type inflight[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+// singleflightGroup coalesces concurrent loads for the same key. It's kept
+// separate from Cache's data/mu so a slow loader call never blocks Set/Get
+// for other keys.
+type singleflightGroup[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*inflight[V]
+}
+
+// GetOrLoad returns the cached value for key if present, otherwise calls
+// loader, caches its result with the given ttl, and returns it. Concurrent
+// GetOrLoad calls for the same key share a single loader invocation: the
+// first caller runs loader while later callers wait on its result, so a
+// cache miss under load doesn't turn into a thundering herd of identical
+// work.
+func (c *TypedCache[K, V]) GetOrLoad(key K, ttl time.Duration, loader func() (V, error)) (V, error) {
+	return groupGetOrLoad(&c.sf, key, ttl, loader, c.Get, func(k K, v V, d time.Duration) {
+		c.Set(k, v, d)
+	})
+}
+
+// groupGetOrLoad implements the singleflight coalescing shared by
+// TypedCache.GetOrLoad and CacheWithPolicy.GetOrLoad. get and set are
+// passed in, rather than called directly on a *TypedCache, so a wrapper
+// like CacheWithPolicy can supply its own Get/Set and keep its extra
+// bookkeeping (eviction policy, maxEntries) in sync — calling the embedded
+// TypedCache's Get/Set directly would silently bypass it.
+func groupGetOrLoad[K comparable, V any](
+	sf *singleflightGroup[K, V],
+	key K,
+	ttl time.Duration,
+	loader func() (V, error),
+	get func(K) (V, bool),
+	set func(K, V, time.Duration),
+) (V, error) {
+	if value, ok := get(key); ok {
+		return value, nil
+	}
+
+	sf.mu.Lock()
+	if call, ok := sf.calls[key]; ok {
+		sf.mu.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+
+	call := &inflight[V]{}
+	call.wg.Add(1)
+	if sf.calls == nil {
+		sf.calls = make(map[K]*inflight[V])
+	}
+	sf.calls[key] = call
+	sf.mu.Unlock()
+
+	call.value, call.err = loader()
+	if call.err == nil {
+		set(key, call.value, ttl)
+	}
+
+	sf.mu.Lock()
+	delete(sf.calls, key)
+	sf.mu.Unlock()
+
+	call.wg.Done()
+	return call.value, call.err
+}
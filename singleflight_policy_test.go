@@ -0,0 +1,29 @@
+package gocache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheWithPolicyGetOrLoadRespectsMaxEntries(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](2, NewLRU[string]())
+	c.Set("a", 1, time.Minute)
+	c.Set("b", 2, time.Minute)
+
+	got, err := c.GetOrLoad("c", time.Minute, func() (int, error) {
+		return 3, nil
+	})
+	if err != nil {
+		t.Fatalf("GetOrLoad: %v", err)
+	}
+	if got != 3 {
+		t.Fatalf("GetOrLoad(\"c\") = %d, want 3", got)
+	}
+
+	if n := len(c.data); n != 2 {
+		t.Fatalf("len(data) = %d, want 2 (GetOrLoad must evict through the policy, not bypass it)", n)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatal(`least recently used key "a" should have been evicted by GetOrLoad's Set`)
+	}
+}